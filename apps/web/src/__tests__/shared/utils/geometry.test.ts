@@ -0,0 +1,55 @@
+// Tests for navigation geometry utilities
+
+import { describe, it, expect } from 'vitest';
+import { courseToSteer } from '@shared/utils/geometry';
+
+describe('Geometry Utilities', () => {
+  describe('courseToSteer', () => {
+    it('should correct into a pure cross-set current', () => {
+      const result = courseToSteer(0, 6, 90, 2);
+
+      expect(result).not.toBeNull();
+      expect(result!.courseToSteerDeg).toBeCloseTo(340.53, 2);
+      expect(result!.speedMadeGoodKts).toBeCloseTo(5.657, 3);
+    });
+
+    it('should wrap course to steer into 0-360 range', () => {
+      const result = courseToSteer(350, 6, 90, 2);
+
+      expect(result).not.toBeNull();
+      expect(result!.courseToSteerDeg).toBeCloseTo(330.84, 2);
+      expect(result!.courseToSteerDeg).toBeGreaterThanOrEqual(0);
+      expect(result!.courseToSteerDeg).toBeLessThan(360);
+    });
+
+    it('should apply no correction for a fair current', () => {
+      const result = courseToSteer(45, 6, 45, 2);
+
+      expect(result).not.toBeNull();
+      expect(result!.courseToSteerDeg).toBeCloseTo(45, 6);
+      expect(result!.speedMadeGoodKts).toBeCloseTo(8, 6);
+    });
+
+    it('should return null when cross-set exceeds boat speed', () => {
+      expect(courseToSteer(0, 1, 90, 2)).toBeNull();
+    });
+
+    it('should return null when a head current prevents headway', () => {
+      expect(courseToSteer(0, 2, 180, 3)).toBeNull();
+      expect(courseToSteer(0, 2, 180, 2)).toBeNull();
+    });
+
+    it('should return null for non-positive boat speed', () => {
+      expect(courseToSteer(0, 0, 90, 1)).toBeNull();
+      expect(courseToSteer(0, -5, 90, 1)).toBeNull();
+    });
+
+    it('should return null for non-finite inputs', () => {
+      expect(courseToSteer(NaN, 6, 90, 2)).toBeNull();
+      expect(courseToSteer(0, NaN, 90, 2)).toBeNull();
+      expect(courseToSteer(0, 6, NaN, 2)).toBeNull();
+      expect(courseToSteer(0, 6, 90, NaN)).toBeNull();
+      expect(courseToSteer(0, Infinity, 90, 2)).toBeNull();
+    });
+  });
+});
@@ -93,3 +93,31 @@ export function routeLengthNm(waypoints: RouteWaypoint[]): number {
   }
   return total
 }
+
+export interface CourseToSteer {
+  courseToSteerDeg: number
+  speedMadeGoodKts: number
+}
+
+/**
+ * Solves the tidal stream triangle: the heading to steer through the water so the
+ * vessel tracks along `trackDeg`, and the resulting speed made good over ground.
+ * Returns null for non-finite inputs, when the cross-track set exceeds boat speed, or when
+ * the vessel cannot make headway.
+ */
+export function courseToSteer(trackDeg: number, boatSpeedKts: number, setDeg: number, driftKts: number): CourseToSteer | null {
+  if (![trackDeg, boatSpeedKts, setDeg, driftKts].every(Number.isFinite)) return null
+  if (boatSpeedKts <= 0) return null
+
+  const relativeSet = (setDeg - trackDeg) * DEG_TO_RAD
+  const crossCurrent = driftKts * Math.sin(relativeSet)
+  const alongCurrent = driftKts * Math.cos(relativeSet)
+  if (Math.abs(crossCurrent) > boatSpeedKts) return null
+
+  const correction = Math.asin(crossCurrent / boatSpeedKts)
+  const speedMadeGoodKts = boatSpeedKts * Math.cos(correction) + alongCurrent
+  if (speedMadeGoodKts <= 0) return null
+
+  const courseToSteerDeg = (((trackDeg - correction / DEG_TO_RAD) % 360) + 360) % 360
+  return { courseToSteerDeg, speedMadeGoodKts }
+}